@@ -0,0 +1,130 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes"
+	corelisters "k8s.io/client-go/listers/core/v1"
+	storagelisters "k8s.io/client-go/listers/storage/v1"
+
+	"go.uber.org/zap"
+)
+
+const enrichmentResyncPeriod = 10 * time.Minute
+
+// pvcEnrichment carries the PVC/PV/StorageClass metadata joined into
+// emitted metrics when --enrich-from-apiserver is set. The zero value is
+// the fallback used on a cache miss: every label field is the empty string,
+// which matches today's minimal label set, and RequestedBytes is 0.
+type pvcEnrichment struct {
+	StorageClass   string
+	VolumeName     string
+	CSIDriver      string
+	VolumeMode     string
+	AccessMode     string
+	RequestedBytes uint64
+}
+
+// enrichmentCache maintains informer-backed caches of PersistentVolumeClaim,
+// PersistentVolume, and StorageClass objects and joins them on demand.
+type enrichmentCache struct {
+	pvcLister corelisters.PersistentVolumeClaimLister
+	pvLister  corelisters.PersistentVolumeLister
+	scLister  storagelisters.StorageClassLister
+	logger    *zap.Logger
+}
+
+// newEnrichmentCache starts PVC/PV/StorageClass informers against the given
+// clientset and blocks until their caches have synced. The clientset is
+// shared with cluster-mode node discovery rather than each opening its own
+// connection to the API server.
+func newEnrichmentCache(logger *zap.Logger, clientset kubernetes.Interface) (*enrichmentCache, error) {
+	factory := informers.NewSharedInformerFactory(clientset, enrichmentResyncPeriod)
+	// Listers must be requested before Start: the factory only starts (and
+	// WaitForCacheSync only waits on) informers registered by then.
+	pvcLister := factory.Core().V1().PersistentVolumeClaims().Lister()
+	pvLister := factory.Core().V1().PersistentVolumes().Lister()
+	scLister := factory.Storage().V1().StorageClasses().Lister()
+
+	stopCh := make(chan struct{})
+	factory.Start(stopCh)
+
+	for informerType, ok := range factory.WaitForCacheSync(stopCh) {
+		if !ok {
+			return nil, fmt.Errorf("informer cache for %v failed to sync", informerType)
+		}
+	}
+
+	return &enrichmentCache{
+		pvcLister: pvcLister,
+		pvLister:  pvLister,
+		scLister:  scLister,
+		logger:    logger,
+	}, nil
+}
+
+// lookup joins the PVC named namespace/name with its bound PersistentVolume
+// and StorageClass. It returns ok=false on a cache miss so the caller can
+// fall through to the minimal label set rather than dropping the metric.
+func (e *enrichmentCache) lookup(namespace, name string) (pvcEnrichment, bool) {
+	pvc, err := e.pvcLister.PersistentVolumeClaims(namespace).Get(name)
+	if err != nil {
+		e.logger.Debug("PVC not found in enrichment cache",
+			zap.String("namespace", namespace),
+			zap.String("persistentvolumeclaim", name),
+			zap.Error(err),
+		)
+		return pvcEnrichment{}, false
+	}
+
+	enrichment := pvcEnrichment{
+		VolumeName: pvc.Spec.VolumeName,
+	}
+
+	if pvc.Spec.StorageClassName != nil {
+		enrichment.StorageClass = *pvc.Spec.StorageClassName
+	}
+
+	if len(pvc.Spec.AccessModes) > 0 {
+		modes := make([]string, 0, len(pvc.Spec.AccessModes))
+		for _, m := range pvc.Spec.AccessModes {
+			modes = append(modes, string(m))
+		}
+		enrichment.AccessMode = strings.Join(modes, ",")
+	}
+
+	if pvc.Spec.VolumeMode != nil {
+		enrichment.VolumeMode = string(*pvc.Spec.VolumeMode)
+	}
+
+	if requested, ok := pvc.Spec.Resources.Requests[corev1.ResourceStorage]; ok {
+		enrichment.RequestedBytes = uint64(requested.Value())
+	}
+
+	if enrichment.VolumeName != "" {
+		if pv, err := e.pvLister.Get(enrichment.VolumeName); err == nil {
+			if pv.Spec.CSI != nil {
+				enrichment.CSIDriver = pv.Spec.CSI.Driver
+			}
+			if enrichment.StorageClass == "" {
+				enrichment.StorageClass = pv.Spec.StorageClassName
+			}
+		}
+	}
+
+	// Fall back to the StorageClass's provisioner when the PV lookup missed
+	// (a VolumeBindingWaitForFirstConsumer PVC that hasn't bound yet) or the
+	// PV isn't CSI-backed (an in-tree plugin volume), so csi_driver still
+	// reflects the provisioner that will (or did) create the volume.
+	if enrichment.CSIDriver == "" && enrichment.StorageClass != "" {
+		if sc, err := e.scLister.Get(enrichment.StorageClass); err == nil {
+			enrichment.CSIDriver = sc.Provisioner
+		}
+	}
+
+	return enrichment, true
+}
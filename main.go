@@ -10,6 +10,7 @@ import (
 	"net/http"
 	"os"
 	"os/signal"
+	"sync"
 	"syscall"
 	"time"
 
@@ -19,19 +20,55 @@ import (
 )
 
 const (
-	defaultMetricsPort     = 8080
-	defaultScrapeInterval  = 30 * time.Second
-	defaultKubeletEndpoint = "https://127.0.0.1:10250"
+	defaultMetricsPort         = 8080
+	defaultScrapeInterval      = 30 * time.Second
+	defaultKubeletEndpoint     = "https://127.0.0.1:10250"
+	defaultConcurrency         = 10
+	defaultPredictionWindow    = 6 * time.Hour
+	minPredictionSamples       = 3
+	defaultTokenReloadInterval = 5 * time.Minute
+	defaultCAReloadInterval    = 10 * time.Minute
+
+	// modeNode scrapes a single kubelet endpoint, the historical DaemonSet
+	// deployment model. modeCluster discovers every Node via the Kubernetes
+	// API and fans out scrapes to each of their kubelets in parallel.
+	modeNode    = "node"
+	modeCluster = "cluster"
+
+	// sourceSummary scrapes the kubelet's /stats/summary endpoint, the
+	// historical (and still default) data source. sourcePrometheus scrapes
+	// the kubelet's own /metrics and /metrics/cadvisor Prometheus endpoints
+	// and re-exports the kubelet_volume_stats_* families found there
+	// directly, for hardened clusters that disable the summary API.
+	sourceSummary    = "summary"
+	sourcePrometheus = "prometheus"
 )
 
+// enrichedLabels is the label set shared by every per-volume gauge. The
+// storageclass/volume_name/csi_driver/volume_mode/access_mode labels are
+// only populated when --enrich-from-apiserver is set and the PVC is found in
+// the enrichment cache; otherwise they are left as the empty string.
+var enrichedLabels = []string{
+	"namespace", "persistentvolumeclaim", "pod", "node",
+	"storageclass", "volume_name", "csi_driver", "volume_mode", "access_mode",
+}
+
 var (
 	// Command-line flags
-	kubeletEndpoint = flag.String("kubelet-endpoint", defaultKubeletEndpoint, "Kubelet endpoint URL")
-	metricsPort     = flag.Int("metrics-port", defaultMetricsPort, "Port to expose Prometheus metrics")
-	scrapeInterval  = flag.Duration("scrape-interval", defaultScrapeInterval, "Interval to scrape kubelet stats")
-	tokenPath       = flag.String("token-path", "/var/run/secrets/kubernetes.io/serviceaccount/token", "Path to service account token")
-	insecureSkipTLS = flag.Bool("insecure-skip-tls-verify", false, "Skip TLS certificate verification")
-	debugMode       = flag.Bool("debug", false, "Enable debug logging including raw API responses")
+	kubeletEndpoint     = flag.String("kubelet-endpoint", defaultKubeletEndpoint, "Kubelet endpoint URL (mode=node only)")
+	metricsPort         = flag.Int("metrics-port", defaultMetricsPort, "Port to expose Prometheus metrics")
+	scrapeInterval      = flag.Duration("scrape-interval", defaultScrapeInterval, "Interval to scrape kubelet stats")
+	tokenPath           = flag.String("token-path", "/var/run/secrets/kubernetes.io/serviceaccount/token", "Path to service account token")
+	insecureSkipTLS     = flag.Bool("insecure-skip-tls-verify", false, "Skip TLS certificate verification")
+	debugMode           = flag.Bool("debug", false, "Enable debug logging including raw API responses")
+	mode                = flag.String("mode", modeNode, "Scrape mode: \"node\" scrapes --kubelet-endpoint directly, \"cluster\" discovers nodes via the Kubernetes API and scrapes all of them")
+	nodeSelector        = flag.String("node-selector", "", "Label selector restricting which nodes are scraped in cluster mode")
+	concurrency         = flag.Int("concurrency", defaultConcurrency, "Maximum number of nodes to scrape in parallel in cluster mode")
+	enrichFromAPIServer = flag.Bool("enrich-from-apiserver", false, "Join PersistentVolumeClaim/PersistentVolume/StorageClass metadata from the Kubernetes API into emitted metrics")
+	source              = flag.String("source", sourceSummary, "Stats source: \"summary\" scrapes /stats/summary, \"prometheus\" scrapes the kubelet's /metrics and /metrics/cadvisor endpoints directly")
+	predictionWindow    = flag.Duration("prediction-window", defaultPredictionWindow, "How much sample history to retain per PVC when predicting time-to-full")
+	tokenReloadInterval = flag.Duration("token-reload-interval", defaultTokenReloadInterval, "How often to re-read the service account token from disk")
+	caReloadInterval    = flag.Duration("ca-reload-interval", defaultCAReloadInterval, "How often to re-read the kubelet CA bundle from disk")
 
 	// Prometheus metrics
 	volumeCapacityBytes = prometheus.NewGaugeVec(
@@ -39,7 +76,7 @@ var (
 			Name: "kubelet_volume_stats_capacity_bytes",
 			Help: "Capacity in bytes of the volume",
 		},
-		[]string{"namespace", "persistentvolumeclaim", "pod"},
+		enrichedLabels,
 	)
 
 	volumeAvailableBytes = prometheus.NewGaugeVec(
@@ -47,7 +84,7 @@ var (
 			Name: "kubelet_volume_stats_available_bytes",
 			Help: "Number of available bytes in the volume",
 		},
-		[]string{"namespace", "persistentvolumeclaim", "pod"},
+		enrichedLabels,
 	)
 
 	volumeUsedBytes = prometheus.NewGaugeVec(
@@ -55,7 +92,7 @@ var (
 			Name: "kubelet_volume_stats_used_bytes",
 			Help: "Number of used bytes in the volume",
 		},
-		[]string{"namespace", "persistentvolumeclaim", "pod"},
+		enrichedLabels,
 	)
 
 	volumeInodesTotal = prometheus.NewGaugeVec(
@@ -63,7 +100,7 @@ var (
 			Name: "kubelet_volume_stats_inodes",
 			Help: "Maximum number of inodes in the volume",
 		},
-		[]string{"namespace", "persistentvolumeclaim", "pod"},
+		enrichedLabels,
 	)
 
 	volumeInodesFree = prometheus.NewGaugeVec(
@@ -71,7 +108,7 @@ var (
 			Name: "kubelet_volume_stats_inodes_free",
 			Help: "Number of free inodes in the volume",
 		},
-		[]string{"namespace", "persistentvolumeclaim", "pod"},
+		enrichedLabels,
 	)
 
 	volumeInodesUsed = prometheus.NewGaugeVec(
@@ -79,14 +116,15 @@ var (
 			Name: "kubelet_volume_stats_inodes_used",
 			Help: "Number of used inodes in the volume",
 		},
-		[]string{"namespace", "persistentvolumeclaim", "pod"},
+		enrichedLabels,
 	)
 
-	scrapeErrorsTotal = prometheus.NewCounter(
+	scrapeErrorsTotal = prometheus.NewCounterVec(
 		prometheus.CounterOpts{
 			Name: "kubelet_volume_stats_scrape_errors_total",
 			Help: "Total number of errors while scraping kubelet stats",
 		},
+		[]string{"node"},
 	)
 
 	lastScrapeTimestamp = prometheus.NewGauge(
@@ -95,8 +133,154 @@ var (
 			Help: "Timestamp of the last successful scrape",
 		},
 	)
+
+	nodeFsCapacityBytes = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "kubelet_node_fs_capacity_bytes",
+			Help: "Capacity in bytes of the node's root filesystem",
+		},
+		[]string{"node"},
+	)
+
+	nodeFsAvailableBytes = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "kubelet_node_fs_available_bytes",
+			Help: "Number of available bytes on the node's root filesystem",
+		},
+		[]string{"node"},
+	)
+
+	nodeFsUsedBytes = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "kubelet_node_fs_used_bytes",
+			Help: "Number of used bytes on the node's root filesystem",
+		},
+		[]string{"node"},
+	)
+
+	nodeFsInodesTotal = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "kubelet_node_fs_inodes",
+			Help: "Maximum number of inodes on the node's root filesystem",
+		},
+		[]string{"node"},
+	)
+
+	nodeFsInodesFree = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "kubelet_node_fs_inodes_free",
+			Help: "Number of free inodes on the node's root filesystem",
+		},
+		[]string{"node"},
+	)
+
+	nodeFsInodesUsed = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "kubelet_node_fs_inodes_used",
+			Help: "Number of used inodes on the node's root filesystem",
+		},
+		[]string{"node"},
+	)
+
+	nodeImageFsCapacityBytes = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "kubelet_node_imagefs_capacity_bytes",
+			Help: "Capacity in bytes of the node's image filesystem",
+		},
+		[]string{"node"},
+	)
+
+	nodeImageFsAvailableBytes = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "kubelet_node_imagefs_available_bytes",
+			Help: "Number of available bytes on the node's image filesystem",
+		},
+		[]string{"node"},
+	)
+
+	nodeImageFsUsedBytes = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "kubelet_node_imagefs_used_bytes",
+			Help: "Number of used bytes on the node's image filesystem",
+		},
+		[]string{"node"},
+	)
+
+	podEphemeralStorageCapacityBytes = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "kubelet_pod_ephemeral_storage_capacity_bytes",
+			Help: "Capacity in bytes of the pod's ephemeral storage",
+		},
+		[]string{"namespace", "pod", "node"},
+	)
+
+	podEphemeralStorageUsedBytes = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "kubelet_pod_ephemeral_storage_used_bytes",
+			Help: "Number of used bytes of the pod's ephemeral storage",
+		},
+		[]string{"namespace", "pod", "node"},
+	)
+
+	podEphemeralStorageAvailableBytes = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "kubelet_pod_ephemeral_storage_available_bytes",
+			Help: "Number of available bytes of the pod's ephemeral storage",
+		},
+		[]string{"namespace", "pod", "node"},
+	)
+
+	// volumeUsedBytesGrowthRate and volumeSecondsUntilFull are fit from a
+	// linear regression over the last --prediction-window of samples for
+	// each PVC; see predictor in prediction.go.
+	volumeUsedBytesGrowthRate = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "kubelet_volume_stats_used_bytes_growth_rate",
+			Help: "Predicted rate of change in used bytes, in bytes per second, fit over the prediction window. May be negative.",
+		},
+		[]string{"namespace", "persistentvolumeclaim", "pod", "node"},
+	)
+
+	volumeSecondsUntilFull = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "kubelet_volume_stats_seconds_until_full",
+			Help: "Predicted number of seconds until the volume reaches capacity at the current growth rate. +Inf if the volume is not growing.",
+		},
+		[]string{"namespace", "persistentvolumeclaim", "pod", "node"},
+	)
+
+	// kubePersistentVolumeClaimInfo is only populated when
+	// --enrich-from-apiserver is set. Its value is the PVC's requested
+	// storage in bytes; the pod label identifies the PVC's owning pod, like
+	// kube-state-metrics' *_info metrics do for other resource kinds.
+	kubePersistentVolumeClaimInfo = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "kube_persistentvolumeclaim_info",
+			Help: "Requested storage size in bytes and owning pod metadata for a PersistentVolumeClaim",
+		},
+		[]string{"namespace", "persistentvolumeclaim", "storageclass", "volume_name", "csi_driver", "volume_mode", "access_mode", "pod"},
+	)
+
+	tokenReloadsTotal = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Name: "kubelet_volume_stats_token_reloads_total",
+			Help: "Total number of times the service account token was re-read from disk",
+		},
+	)
 )
 
+// statusCodeError is returned by fetchStats when the kubelet responds with a
+// non-200 status, so callers can distinguish e.g. a 403/404 (summary API
+// disabled) from a transport failure and fall back to --source=prometheus.
+type statusCodeError struct {
+	StatusCode int
+	Body       string
+}
+
+func (e *statusCodeError) Error() string {
+	return fmt.Sprintf("unexpected status code %d: %s", e.StatusCode, e.Body)
+}
+
 // StatsResponse represents the kubelet /stats/summary response
 type StatsResponse struct {
 	Node NodeStats  `json:"node"`
@@ -104,7 +288,16 @@ type StatsResponse struct {
 }
 
 type NodeStats struct {
-	NodeName string `json:"nodeName"`
+	NodeName string        `json:"nodeName"`
+	Fs       *VolumeStats  `json:"fs,omitempty"`
+	Runtime  *RuntimeStats `json:"runtime,omitempty"`
+}
+
+// RuntimeStats holds the container runtime's own filesystem stats, most
+// notably imageFs, which is what fills up when a node can no longer pull or
+// unpack images.
+type RuntimeStats struct {
+	ImageFs *VolumeStats `json:"imageFs,omitempty"`
 }
 
 type PodStats struct {
@@ -138,8 +331,20 @@ type PVCRef struct {
 
 type VolumeStatsCollector struct {
 	client *http.Client
-	token  string
+	token  *tokenSource
 	logger *zap.Logger
+
+	// nodeLister is only set in cluster mode, where a single collector fans
+	// out scrapes across every node's kubelet instead of talking to
+	// *kubeletEndpoint directly; concurrency is only used in that mode.
+	nodeLister  *nodeLister
+	concurrency int
+
+	// enricher is only set when --enrich-from-apiserver is passed.
+	enricher *enrichmentCache
+
+	// predictor fits a per-PVC time-to-full prediction over --prediction-window.
+	predictor *predictor
 }
 
 func init() {
@@ -152,6 +357,22 @@ func init() {
 	prometheus.MustRegister(volumeInodesUsed)
 	prometheus.MustRegister(scrapeErrorsTotal)
 	prometheus.MustRegister(lastScrapeTimestamp)
+	prometheus.MustRegister(kubePersistentVolumeClaimInfo)
+	prometheus.MustRegister(nodeFsCapacityBytes)
+	prometheus.MustRegister(nodeFsAvailableBytes)
+	prometheus.MustRegister(nodeFsUsedBytes)
+	prometheus.MustRegister(nodeFsInodesTotal)
+	prometheus.MustRegister(nodeFsInodesFree)
+	prometheus.MustRegister(nodeFsInodesUsed)
+	prometheus.MustRegister(nodeImageFsCapacityBytes)
+	prometheus.MustRegister(nodeImageFsAvailableBytes)
+	prometheus.MustRegister(nodeImageFsUsedBytes)
+	prometheus.MustRegister(podEphemeralStorageCapacityBytes)
+	prometheus.MustRegister(podEphemeralStorageUsedBytes)
+	prometheus.MustRegister(podEphemeralStorageAvailableBytes)
+	prometheus.MustRegister(volumeUsedBytesGrowthRate)
+	prometheus.MustRegister(volumeSecondsUntilFull)
+	prometheus.MustRegister(tokenReloadsTotal)
 }
 
 func main() {
@@ -172,40 +393,96 @@ func main() {
 	defer logger.Sync()
 
 	logger.Info("Starting kubelet volume stats exporter",
+		zap.String("mode", *mode),
 		zap.String("kubelet_endpoint", *kubeletEndpoint),
 		zap.Int("metrics_port", *metricsPort),
 		zap.Duration("scrape_interval", *scrapeInterval),
 		zap.Bool("debug_mode", *debugMode),
 	)
 
-	// Read service account token
-	token, err := readToken(*tokenPath)
+	if *mode != modeNode && *mode != modeCluster {
+		logger.Fatal("Invalid --mode, must be \"node\" or \"cluster\"", zap.String("mode", *mode))
+	}
+
+	if *concurrency <= 0 {
+		logger.Fatal("Invalid --concurrency, must be greater than zero", zap.Int("concurrency", *concurrency))
+	}
+
+	// Start metrics collection in background. ctx is created up front so the
+	// token/CA reload loops below can be tied to the same lifetime.
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// Load the service account token once and keep it fresh: with
+	// BoundServiceAccountTokenVolume projected tokens (default in modern
+	// clusters), a token read once at startup expires and is rotated on
+	// disk, silently breaking scrapes after about an hour.
+	token, err := newTokenSource(logger, *tokenPath)
 	if err != nil {
 		logger.Warn("Failed to read service account token, proceeding without authentication",
 			zap.Error(err),
 		)
+		token = newEmptyTokenSource(logger, *tokenPath)
 	}
+	go token.run(ctx, *tokenReloadInterval)
 
-	// Create HTTP client
-	tlsConfig := &tls.Config{
-		InsecureSkipVerify: *insecureSkipTLS,
+	prometheus.MustRegister(prometheus.NewGaugeFunc(
+		prometheus.GaugeOpts{
+			Name: "kubelet_volume_stats_token_age_seconds",
+			Help: "Seconds since the service account token was last successfully reloaded from disk",
+		},
+		func() float64 { return time.Since(token.LoadedAt()).Seconds() },
+	))
+
+	// Build the HTTP transport. Trusting the kubelet CA bundle (reloaded
+	// periodically, since it can be rotated underneath a long-lived process
+	// too) means --insecure-skip-tls-verify is no longer the practical
+	// default it used to be.
+	var tlsConfig *tls.Config
+	if *insecureSkipTLS {
+		tlsConfig = &tls.Config{InsecureSkipVerify: true}
+	} else {
+		ca, err := newCAPool(logger, inClusterCACertPath)
+		if err != nil {
+			logger.Fatal("Failed to load kubelet CA bundle", zap.Error(err))
+		}
+		go ca.run(ctx, *caReloadInterval)
+		tlsConfig = ca.tlsConfig()
 	}
 	client := &http.Client{
-		Timeout: 10 * time.Second,
-		Transport: &http.Transport{
-			TLSClientConfig: tlsConfig,
-		},
+		Timeout:   10 * time.Second,
+		Transport: &http.Transport{TLSClientConfig: tlsConfig},
 	}
 
 	collector := &VolumeStatsCollector{
-		client: client,
-		token:  token,
-		logger: logger,
+		client:      client,
+		token:       token,
+		logger:      logger,
+		concurrency: *concurrency,
+		predictor:   newPredictor(*predictionWindow),
 	}
 
-	// Start metrics collection in background
-	ctx, cancel := context.WithCancel(context.Background())
-	defer cancel()
+	// Cluster-mode node discovery and --enrich-from-apiserver both talk to
+	// the Kubernetes API with the same credentials, so they share a single
+	// clientset and watch connection rather than each opening their own.
+	if *mode == modeCluster || *enrichFromAPIServer {
+		clientset, err := newInClusterClientset()
+		if err != nil {
+			logger.Fatal("Failed to build Kubernetes API client", zap.Error(err))
+		}
+
+		if *mode == modeCluster {
+			collector.nodeLister = newNodeLister(logger, clientset, *nodeSelector)
+		}
+
+		if *enrichFromAPIServer {
+			enricher, err := newEnrichmentCache(logger, clientset)
+			if err != nil {
+				logger.Fatal("Failed to initialize apiserver enrichment cache", zap.Error(err))
+			}
+			collector.enricher = enricher
+		}
+	}
 
 	go collector.collectLoop(ctx)
 
@@ -264,15 +541,32 @@ func (c *VolumeStatsCollector) collectLoop(ctx context.Context) {
 }
 
 func (c *VolumeStatsCollector) collectOnce() {
+	if c.nodeLister != nil {
+		c.collectCluster()
+		return
+	}
+
 	c.logger.Debug("Starting volume stats collection")
 
-	stats, err := c.fetchStats()
+	if *source == sourcePrometheus {
+		c.collectFromPrometheusSource(*kubeletEndpoint)
+		return
+	}
+
+	stats, err := c.fetchStats(*kubeletEndpoint)
 	if err != nil {
+		if shouldFallBackToPrometheusSource(err) {
+			c.logger.Warn("Summary API unavailable, falling back to --source=prometheus for this scrape",
+				zap.Error(err),
+			)
+			c.collectFromPrometheusSource(*kubeletEndpoint)
+			return
+		}
 		c.logger.Error("Failed to fetch stats",
 			zap.Error(err),
 			zap.String("kubelet_endpoint", *kubeletEndpoint),
 		)
-		scrapeErrorsTotal.Inc()
+		scrapeErrorsTotal.WithLabelValues("").Inc()
 		return
 	}
 
@@ -280,7 +574,10 @@ func (c *VolumeStatsCollector) collectOnce() {
 		zap.Int("pod_count", len(stats.Pods)),
 	)
 
+	resetMetrics()
+	c.predictor.beginCycle()
 	c.updateMetrics(stats)
+	c.predictor.endCycle()
 	lastScrapeTimestamp.SetToCurrentTime()
 
 	c.logger.Debug("Volume stats collection completed",
@@ -288,8 +585,74 @@ func (c *VolumeStatsCollector) collectOnce() {
 	)
 }
 
-func (c *VolumeStatsCollector) fetchStats() (*StatsResponse, error) {
-	url := fmt.Sprintf("%s/stats/summary", *kubeletEndpoint)
+// collectCluster discovers every node via the Kubernetes API and scrapes
+// each of their kubelets in parallel, bounded by c.concurrency.
+func (c *VolumeStatsCollector) collectCluster() {
+	ctx, cancel := context.WithTimeout(context.Background(), *scrapeInterval)
+	defer cancel()
+
+	targets, err := c.nodeLister.List(ctx)
+	if err != nil {
+		c.logger.Error("Failed to list nodes for cluster-mode scraping", zap.Error(err))
+		scrapeErrorsTotal.WithLabelValues("").Inc()
+		return
+	}
+
+	c.logger.Debug("Discovered nodes to scrape", zap.Int("node_count", len(targets)))
+
+	resetMetrics()
+	c.predictor.beginCycle()
+
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, c.concurrency)
+	for _, target := range targets {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(target NodeTarget) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			c.scrapeNode(target)
+		}(target)
+	}
+	wg.Wait()
+
+	c.predictor.endCycle()
+	lastScrapeTimestamp.SetToCurrentTime()
+}
+
+func (c *VolumeStatsCollector) scrapeNode(target NodeTarget) {
+	if *source == sourcePrometheus {
+		c.collectFromPrometheusSource(target.Endpoint)
+		return
+	}
+
+	stats, err := c.fetchStats(target.Endpoint)
+	if err != nil {
+		if shouldFallBackToPrometheusSource(err) {
+			c.logger.Warn("Summary API unavailable on node, falling back to --source=prometheus for this scrape",
+				zap.String("node", target.Name),
+				zap.Error(err),
+			)
+			c.collectFromPrometheusSource(target.Endpoint)
+			return
+		}
+		c.logger.Error("Failed to fetch stats from node",
+			zap.Error(err),
+			zap.String("node", target.Name),
+			zap.String("endpoint", target.Endpoint),
+		)
+		scrapeErrorsTotal.WithLabelValues(target.Name).Inc()
+		return
+	}
+
+	if stats.Node.NodeName == "" {
+		stats.Node.NodeName = target.Name
+	}
+	c.updateMetrics(stats)
+}
+
+func (c *VolumeStatsCollector) fetchStats(endpoint string) (*StatsResponse, error) {
+	url := fmt.Sprintf("%s/stats/summary", endpoint)
 
 	c.logger.Debug("Fetching stats from kubelet", zap.String("url", url))
 
@@ -298,8 +661,8 @@ func (c *VolumeStatsCollector) fetchStats() (*StatsResponse, error) {
 		return nil, fmt.Errorf("failed to create request: %w", err)
 	}
 
-	if c.token != "" {
-		req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", c.token))
+	if token := c.token.Token(); token != "" {
+		req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token))
 	}
 
 	resp, err := c.client.Do(req)
@@ -314,7 +677,7 @@ func (c *VolumeStatsCollector) fetchStats() (*StatsResponse, error) {
 			zap.Int("status_code", resp.StatusCode),
 			zap.String("response_body", string(body)),
 		)
-		return nil, fmt.Errorf("unexpected status code %d: %s", resp.StatusCode, string(body))
+		return nil, &statusCodeError{StatusCode: resp.StatusCode, Body: string(body)}
 	}
 
 	// Read the entire response body for debugging
@@ -460,18 +823,42 @@ func min(a, b int) int {
 	return b
 }
 
-func (c *VolumeStatsCollector) updateMetrics(stats *StatsResponse) {
-	// Clear old metrics to avoid stale data
+// resetMetrics clears all per-volume gauges. It is called once per
+// collection cycle, before updateMetrics runs for any scrape target, so that
+// stale series are dropped without clobbering the results of other targets
+// in the same cycle (cluster mode scrapes many nodes per cycle).
+func resetMetrics() {
 	volumeCapacityBytes.Reset()
 	volumeAvailableBytes.Reset()
 	volumeUsedBytes.Reset()
 	volumeInodesTotal.Reset()
 	volumeInodesFree.Reset()
 	volumeInodesUsed.Reset()
+	kubePersistentVolumeClaimInfo.Reset()
+	nodeFsCapacityBytes.Reset()
+	nodeFsAvailableBytes.Reset()
+	nodeFsUsedBytes.Reset()
+	nodeFsInodesTotal.Reset()
+	nodeFsInodesFree.Reset()
+	nodeFsInodesUsed.Reset()
+	nodeImageFsCapacityBytes.Reset()
+	nodeImageFsAvailableBytes.Reset()
+	nodeImageFsUsedBytes.Reset()
+	podEphemeralStorageCapacityBytes.Reset()
+	podEphemeralStorageUsedBytes.Reset()
+	podEphemeralStorageAvailableBytes.Reset()
+	volumeUsedBytesGrowthRate.Reset()
+	volumeSecondsUntilFull.Reset()
+}
+
+func (c *VolumeStatsCollector) updateMetrics(stats *StatsResponse) {
+	c.updateNodeFsMetrics(stats)
 
 	volumeCount := 0
 	podCount := 0
 	for _, pod := range stats.Pods {
+		c.updateEphemeralStorageMetrics(stats.Node.NodeName, &pod)
+
 		// Only process pods that have volumes with PVC references
 		hasRelevantVolumes := false
 		for _, vol := range pod.Volume {
@@ -523,6 +910,40 @@ func (c *VolumeStatsCollector) updateMetrics(stats *StatsResponse) {
 				"namespace":             pod.PodRef.Namespace,
 				"persistentvolumeclaim": volume.PVCRef.Name,
 				"pod":                   pod.PodRef.Name,
+				"node":                  stats.Node.NodeName,
+				"storageclass":          "",
+				"volume_name":           "",
+				"csi_driver":            "",
+				"volume_mode":           "",
+				"access_mode":           "",
+			}
+
+			var enrichment pvcEnrichment
+			if c.enricher != nil {
+				if found, ok := c.enricher.lookup(volume.PVCRef.Namespace, volume.PVCRef.Name); ok {
+					enrichment = found
+					labels["storageclass"] = enrichment.StorageClass
+					labels["volume_name"] = enrichment.VolumeName
+					labels["csi_driver"] = enrichment.CSIDriver
+					labels["volume_mode"] = enrichment.VolumeMode
+					labels["access_mode"] = enrichment.AccessMode
+				} else if *debugMode {
+					c.logger.Debug("No apiserver enrichment found for PVC, falling back to minimal labels",
+						zap.String("namespace", volume.PVCRef.Namespace),
+						zap.String("persistentvolumeclaim", volume.PVCRef.Name),
+					)
+				}
+
+				kubePersistentVolumeClaimInfo.With(prometheus.Labels{
+					"namespace":             volume.PVCRef.Namespace,
+					"persistentvolumeclaim": volume.PVCRef.Name,
+					"storageclass":          enrichment.StorageClass,
+					"volume_name":           enrichment.VolumeName,
+					"csi_driver":            enrichment.CSIDriver,
+					"volume_mode":           enrichment.VolumeMode,
+					"access_mode":           enrichment.AccessMode,
+					"pod":                   pod.PodRef.Name,
+				}).Set(float64(enrichment.RequestedBytes))
 			}
 
 			// Log the labels AFTER creation to verify namespace is correct
@@ -556,6 +977,16 @@ func (c *VolumeStatsCollector) updateMetrics(stats *StatsResponse) {
 				volumeInodesUsed.With(labels).Set(float64(*volume.InodesUsed))
 			}
 
+			if volume.UsedBytes != nil && volume.CapacityBytes != nil {
+				predictionLabels := prometheus.Labels{
+					"namespace":             labels["namespace"],
+					"persistentvolumeclaim": labels["persistentvolumeclaim"],
+					"pod":                   labels["pod"],
+					"node":                  labels["node"],
+				}
+				c.updatePrediction(predictionLabels, volume.PVCRef.Namespace, volume.PVCRef.Name, pod.PodRef.Name, *volume.UsedBytes, *volume.CapacityBytes)
+			}
+
 			volumeCount++
 			c.logger.Debug("Updated metrics for volume",
 				zap.String("namespace", pod.PodRef.Namespace),
@@ -575,12 +1006,72 @@ func (c *VolumeStatsCollector) updateMetrics(stats *StatsResponse) {
 	)
 }
 
-func readToken(path string) (string, error) {
-	data, err := os.ReadFile(path)
-	if err != nil {
-		return "", err
+// updateNodeFsMetrics exports the node's root filesystem and imagefs stats
+// already present in /stats/summary but previously discarded. Nodes running
+// out of imagefs is a frequent cause of pod evictions.
+func (c *VolumeStatsCollector) updateNodeFsMetrics(stats *StatsResponse) {
+	node := stats.Node.NodeName
+	fsLabels := prometheus.Labels{"node": node}
+
+	if fs := stats.Node.Fs; fs != nil {
+		if fs.CapacityBytes != nil {
+			nodeFsCapacityBytes.With(fsLabels).Set(float64(*fs.CapacityBytes))
+		}
+		if fs.AvailableBytes != nil {
+			nodeFsAvailableBytes.With(fsLabels).Set(float64(*fs.AvailableBytes))
+		}
+		if fs.UsedBytes != nil {
+			nodeFsUsedBytes.With(fsLabels).Set(float64(*fs.UsedBytes))
+		}
+		if fs.InodesTotal != nil {
+			nodeFsInodesTotal.With(fsLabels).Set(float64(*fs.InodesTotal))
+		}
+		if fs.InodesFree != nil {
+			nodeFsInodesFree.With(fsLabels).Set(float64(*fs.InodesFree))
+		}
+		if fs.InodesUsed != nil {
+			nodeFsInodesUsed.With(fsLabels).Set(float64(*fs.InodesUsed))
+		}
+	}
+
+	if stats.Node.Runtime != nil {
+		if imageFs := stats.Node.Runtime.ImageFs; imageFs != nil {
+			if imageFs.CapacityBytes != nil {
+				nodeImageFsCapacityBytes.With(fsLabels).Set(float64(*imageFs.CapacityBytes))
+			}
+			if imageFs.AvailableBytes != nil {
+				nodeImageFsAvailableBytes.With(fsLabels).Set(float64(*imageFs.AvailableBytes))
+			}
+			if imageFs.UsedBytes != nil {
+				nodeImageFsUsedBytes.With(fsLabels).Set(float64(*imageFs.UsedBytes))
+			}
+		}
+	}
+}
+
+// updateEphemeralStorageMetrics exports a pod's ephemeral-storage stats,
+// which /stats/summary reports for every pod regardless of whether it has
+// any PVC-backed volumes.
+func (c *VolumeStatsCollector) updateEphemeralStorageMetrics(node string, pod *PodStats) {
+	if pod.Ephemeral == nil {
+		return
+	}
+
+	labels := prometheus.Labels{
+		"namespace": pod.PodRef.Namespace,
+		"pod":       pod.PodRef.Name,
+		"node":      node,
+	}
+
+	if pod.Ephemeral.CapacityBytes != nil {
+		podEphemeralStorageCapacityBytes.With(labels).Set(float64(*pod.Ephemeral.CapacityBytes))
+	}
+	if pod.Ephemeral.UsedBytes != nil {
+		podEphemeralStorageUsedBytes.With(labels).Set(float64(*pod.Ephemeral.UsedBytes))
+	}
+	if pod.Ephemeral.AvailableBytes != nil {
+		podEphemeralStorageAvailableBytes.With(labels).Set(float64(*pod.Ephemeral.AvailableBytes))
 	}
-	return string(data), nil
 }
 
 func healthHandler(w http.ResponseWriter, r *http.Request) {
@@ -0,0 +1,116 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"net"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+
+	"go.uber.org/zap"
+)
+
+const defaultKubeletPort = 10250
+
+// NodeTarget identifies a single kubelet to scrape in cluster mode.
+type NodeTarget struct {
+	Name     string
+	Endpoint string
+}
+
+// nodeLister discovers kubelet scrape targets from the Kubernetes API
+// instead of relying on a single static --kubelet-endpoint. It is only
+// constructed in cluster mode.
+type nodeLister struct {
+	clientset    kubernetes.Interface
+	nodeSelector string
+	kubeletPort  int
+	logger       *zap.Logger
+}
+
+// newInClusterClientset builds a Kubernetes clientset from the in-cluster
+// service account config. Both cluster-mode node discovery and
+// --enrich-from-apiserver need a clientset against the same API server, so
+// they share the one built here rather than each opening their own
+// connection and watches.
+func newInClusterClientset() (kubernetes.Interface, error) {
+	cfg, err := rest.InClusterConfig()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load in-cluster config: %w", err)
+	}
+
+	clientset, err := kubernetes.NewForConfig(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build kubernetes client: %w", err)
+	}
+
+	return clientset, nil
+}
+
+// newNodeLister returns a lister that scopes Node discovery to nodeSelector
+// (an empty selector matches every node), using the given clientset.
+func newNodeLister(logger *zap.Logger, clientset kubernetes.Interface, nodeSelector string) *nodeLister {
+	return &nodeLister{
+		clientset:    clientset,
+		nodeSelector: nodeSelector,
+		kubeletPort:  defaultKubeletPort,
+		logger:       logger,
+	}
+}
+
+// List returns one NodeTarget per matching, Ready node.
+func (l *nodeLister) List(ctx context.Context) ([]NodeTarget, error) {
+	nodes, err := l.clientset.CoreV1().Nodes().List(ctx, metav1.ListOptions{
+		LabelSelector: l.nodeSelector,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list nodes: %w", err)
+	}
+
+	targets := make([]NodeTarget, 0, len(nodes.Items))
+	for i := range nodes.Items {
+		node := &nodes.Items[i]
+		if !nodeIsReady(node) {
+			continue
+		}
+
+		addr := nodeInternalIP(node)
+		if addr == "" {
+			l.logger.Warn("Skipping node without an InternalIP address", zap.String("node", node.Name))
+			continue
+		}
+
+		targets = append(targets, NodeTarget{
+			Name:     node.Name,
+			Endpoint: fmt.Sprintf("https://%s", net.JoinHostPort(addr, fmt.Sprintf("%d", l.kubeletPort))),
+		})
+	}
+
+	return targets, nil
+}
+
+func nodeIsReady(node *corev1.Node) bool {
+	for _, cond := range node.Status.Conditions {
+		if cond.Type == corev1.NodeReady {
+			return cond.Status == corev1.ConditionTrue
+		}
+	}
+	return false
+}
+
+func nodeInternalIP(node *corev1.Node) string {
+	for _, addr := range node.Status.Addresses {
+		if addr.Type == corev1.NodeInternalIP {
+			return addr.Address
+		}
+	}
+	return ""
+}
+
+// inClusterCACertPath is the CA bundle used both to verify the API server
+// (by client-go, via rest.InClusterConfig) and, via caPool in token.go, to
+// verify every node's kubelet.
+const inClusterCACertPath = "/var/run/secrets/kubernetes.io/serviceaccount/ca.crt"
@@ -0,0 +1,139 @@
+package main
+
+import (
+	"fmt"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// pvcKey identifies the (namespace, pvc, pod) tuple a prediction ring buffer
+// is tracked under; volumes are re-mounted under the same PVC/pod pair
+// across scrapes, so this is stable across the exporter's lifetime.
+func pvcKey(namespace, pvc, pod string) string {
+	return fmt.Sprintf("%s/%s/%s", namespace, pvc, pod)
+}
+
+type predictionSample struct {
+	t    time.Time
+	used float64
+}
+
+// predictor retains the last --prediction-window of UsedBytes samples per
+// PVC and fits a linear regression to estimate the bytes/sec growth rate.
+type predictor struct {
+	window time.Duration
+
+	mu            sync.Mutex
+	series        map[string][]predictionSample
+	seenThisCycle map[string]struct{}
+}
+
+func newPredictor(window time.Duration) *predictor {
+	return &predictor{
+		window: window,
+		series: make(map[string][]predictionSample),
+	}
+}
+
+// beginCycle starts tracking which PVCs are observed in the collection
+// cycle about to run, so endCycle can drop ring buffers for PVCs that
+// disappeared from the scrape.
+func (p *predictor) beginCycle() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.seenThisCycle = make(map[string]struct{})
+}
+
+// endCycle drops ring buffers for any PVC not observed since the matching
+// beginCycle call, mirroring the lifecycle of the per-cycle gauge Reset()
+// calls in resetMetrics.
+func (p *predictor) endCycle() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	for key := range p.series {
+		if _, ok := p.seenThisCycle[key]; !ok {
+			delete(p.series, key)
+		}
+	}
+	p.seenThisCycle = nil
+}
+
+// observe records a new UsedBytes sample for key and, once at least
+// minPredictionSamples samples fall within the prediction window, returns
+// the least-squares growth rate in bytes/sec.
+func (p *predictor) observe(key string, t time.Time, used float64) (ratePerSecond float64, ok bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.seenThisCycle != nil {
+		p.seenThisCycle[key] = struct{}{}
+	}
+
+	samples := append(p.series[key], predictionSample{t: t, used: used})
+
+	cutoff := t.Add(-p.window)
+	trimmed := samples[:0]
+	for _, s := range samples {
+		if !s.t.Before(cutoff) {
+			trimmed = append(trimmed, s)
+		}
+	}
+	p.series[key] = trimmed
+
+	if len(trimmed) < minPredictionSamples {
+		return 0, false
+	}
+
+	return linearRegressionSlope(trimmed), true
+}
+
+// linearRegressionSlope fits used = a + b*t by ordinary least squares and
+// returns b, the bytes/sec growth rate. t is measured in seconds relative to
+// the first sample to keep the regression numerically well-behaved.
+func linearRegressionSlope(samples []predictionSample) float64 {
+	epoch := samples[0].t
+
+	var n, sumX, sumY, sumXY, sumXX float64
+	for _, s := range samples {
+		x := s.t.Sub(epoch).Seconds()
+		y := s.used
+		n++
+		sumX += x
+		sumY += y
+		sumXY += x * y
+		sumXX += x * x
+	}
+
+	denominator := n*sumXX - sumX*sumX
+	if denominator == 0 {
+		return 0
+	}
+
+	return (n*sumXY - sumX*sumY) / denominator
+}
+
+// updatePrediction fits a growth rate for (namespace, pvc, pod) and, once at
+// least minPredictionSamples samples are available, emits the growth-rate
+// and seconds-until-full gauges for it.
+func (c *VolumeStatsCollector) updatePrediction(labels prometheus.Labels, namespace, pvc, pod string, usedBytes, capacityBytes uint64) {
+	rate, ok := c.predictor.observe(pvcKey(namespace, pvc, pod), time.Now(), float64(usedBytes))
+	if !ok {
+		return
+	}
+
+	volumeUsedBytesGrowthRate.With(labels).Set(rate)
+
+	if rate <= 0 {
+		volumeSecondsUntilFull.With(labels).Set(math.Inf(1))
+		return
+	}
+
+	remaining := float64(capacityBytes) - float64(usedBytes)
+	if remaining < 0 {
+		remaining = 0
+	}
+	volumeSecondsUntilFull.With(labels).Set(remaining / rate)
+}
@@ -0,0 +1,151 @@
+package main
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func TestLinearRegressionSlope(t *testing.T) {
+	epoch := time.Unix(0, 0)
+
+	tests := []struct {
+		name    string
+		samples []predictionSample
+		want    float64
+	}{
+		{
+			name: "perfect linear growth",
+			samples: []predictionSample{
+				{t: epoch, used: 0},
+				{t: epoch.Add(1 * time.Second), used: 10},
+				{t: epoch.Add(2 * time.Second), used: 20},
+			},
+			want: 10,
+		},
+		{
+			name: "flat usage",
+			samples: []predictionSample{
+				{t: epoch, used: 100},
+				{t: epoch.Add(time.Minute), used: 100},
+				{t: epoch.Add(2 * time.Minute), used: 100},
+			},
+			want: 0,
+		},
+		{
+			name: "shrinking usage",
+			samples: []predictionSample{
+				{t: epoch, used: 100},
+				{t: epoch.Add(time.Second), used: 90},
+				{t: epoch.Add(2 * time.Second), used: 80},
+			},
+			want: -10,
+		},
+		{
+			name: "tied timestamps collapse the denominator to zero",
+			samples: []predictionSample{
+				{t: epoch, used: 10},
+				{t: epoch, used: 20},
+				{t: epoch, used: 30},
+			},
+			want: 0,
+		},
+		{
+			name: "single sample has no spread",
+			samples: []predictionSample{
+				{t: epoch, used: 42},
+			},
+			want: 0,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got := linearRegressionSlope(tc.samples)
+			if math.Abs(got-tc.want) > 1e-9 {
+				t.Errorf("linearRegressionSlope() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestPredictorObserveRequiresMinimumSamples(t *testing.T) {
+	p := newPredictor(time.Hour)
+	base := time.Unix(1000, 0)
+
+	if _, ok := p.observe("ns/pvc/pod", base, 0); ok {
+		t.Fatalf("observe() with 1 sample should not be ok")
+	}
+	if _, ok := p.observe("ns/pvc/pod", base.Add(time.Minute), 10); ok {
+		t.Fatalf("observe() with 2 samples should not be ok")
+	}
+	rate, ok := p.observe("ns/pvc/pod", base.Add(2*time.Minute), 20)
+	if !ok {
+		t.Fatalf("observe() with 3 samples should be ok")
+	}
+	if math.Abs(rate-float64(10)/60) > 1e-9 {
+		t.Errorf("rate = %v, want %v", rate, float64(10)/60)
+	}
+}
+
+func TestPredictorObserveTrimsOutsideWindow(t *testing.T) {
+	p := newPredictor(10 * time.Minute)
+	base := time.Unix(1000, 0)
+
+	p.observe("ns/pvc/pod", base, 0)
+	p.observe("ns/pvc/pod", base.Add(8*time.Minute), 80)
+	p.observe("ns/pvc/pod", base.Add(9*time.Minute), 90)
+
+	p.mu.Lock()
+	before := len(p.series["ns/pvc/pod"])
+	p.mu.Unlock()
+	if before != 3 {
+		t.Fatalf("expected 3 samples in window, got %d", before)
+	}
+
+	// This sample's cutoff (t-10m) falls after the first sample, so it
+	// should be trimmed away while the two later ones are kept.
+	if _, ok := p.observe("ns/pvc/pod", base.Add(11*time.Minute), 110); !ok {
+		t.Fatalf("observe() should still be ok after trimming")
+	}
+
+	p.mu.Lock()
+	after := len(p.series["ns/pvc/pod"])
+	p.mu.Unlock()
+	if after != 3 {
+		t.Fatalf("expected 3 samples after trimming the oldest one, got %d", after)
+	}
+}
+
+func TestPredictorEndCycleDropsDisappearedPVCs(t *testing.T) {
+	p := newPredictor(time.Hour)
+	base := time.Unix(1000, 0)
+
+	p.beginCycle()
+	p.observe("ns/pvc-a/pod", base, 0)
+	p.observe("ns/pvc-b/pod", base, 0)
+	p.endCycle()
+
+	p.mu.Lock()
+	if _, ok := p.series["ns/pvc-a/pod"]; !ok {
+		t.Fatalf("pvc-a should still be tracked after its own cycle")
+	}
+	if _, ok := p.series["ns/pvc-b/pod"]; !ok {
+		t.Fatalf("pvc-b should still be tracked after its own cycle")
+	}
+	p.mu.Unlock()
+
+	// pvc-b disappears from the next scrape.
+	p.beginCycle()
+	p.observe("ns/pvc-a/pod", base.Add(time.Minute), 10)
+	p.endCycle()
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if _, ok := p.series["ns/pvc-a/pod"]; !ok {
+		t.Errorf("pvc-a should still be tracked")
+	}
+	if _, ok := p.series["ns/pvc-b/pod"]; ok {
+		t.Errorf("pvc-b should have been dropped after disappearing from a cycle")
+	}
+}
@@ -0,0 +1,198 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/prometheus/common/expfmt"
+	"go.uber.org/zap"
+)
+
+// volumeStatsFamilyPrefix is the only family name prefix re-exported from
+// the kubelet's Prometheus endpoints; everything else (cadvisor's container
+// CPU/memory metrics, etc.) is discarded.
+const volumeStatsFamilyPrefix = "kubelet_volume_stats_"
+
+// prometheusSourcePaths are scraped, in order, when --source=prometheus (or
+// the /stats/summary fallback) is active. Many hardened clusters disable the
+// summary API but keep these open with narrower RBAC.
+var prometheusSourcePaths = []string{"/metrics/cadvisor", "/metrics"}
+
+// shouldFallBackToPrometheusSource reports whether err indicates the
+// kubelet's summary API is unavailable (as opposed to a transient
+// transport failure), so the caller should retry via --source=prometheus.
+func shouldFallBackToPrometheusSource(err error) bool {
+	var statusErr *statusCodeError
+	if !errors.As(err, &statusErr) {
+		return false
+	}
+	return statusErr.StatusCode == http.StatusForbidden || statusErr.StatusCode == http.StatusNotFound
+}
+
+// collectFromPrometheusSource scrapes the kubelet's Prometheus text
+// exposition endpoints directly and re-exports the kubelet_volume_stats_*
+// families found there, preserving their original label sets and tagging
+// each sample with source="metrics" so operators can tell which path
+// produced it.
+func (c *VolumeStatsCollector) collectFromPrometheusSource(endpoint string) {
+	families := make(map[string]*dto.MetricFamily)
+	anyPathSucceeded := false
+
+	for _, path := range prometheusSourcePaths {
+		parsed, err := c.fetchPrometheusFamilies(endpoint, path)
+		if err != nil {
+			c.logger.Error("Failed to scrape kubelet prometheus endpoint",
+				zap.Error(err),
+				zap.String("endpoint", endpoint),
+				zap.String("path", path),
+			)
+			scrapeErrorsTotal.WithLabelValues("").Inc()
+			continue
+		}
+		anyPathSucceeded = true
+		for name, family := range parsed {
+			if family != nil && *family.Name != "" {
+				families[name] = family
+			}
+		}
+	}
+
+	// Key by endpoint rather than replacing the collector's whole state: in
+	// --mode=cluster, every node's scrape runs concurrently and must land in
+	// the same /metrics output instead of the last one to finish clobbering
+	// the rest. On total failure, drop this endpoint's entry rather than
+	// publishing a stale or empty one.
+	if anyPathSucceeded {
+		volumeStatsFromMetrics.update(endpoint, families)
+		lastScrapeTimestamp.SetToCurrentTime()
+	} else {
+		volumeStatsFromMetrics.clear(endpoint)
+	}
+}
+
+func (c *VolumeStatsCollector) fetchPrometheusFamilies(endpoint, path string) (map[string]*dto.MetricFamily, error) {
+	url := fmt.Sprintf("%s%s", endpoint, path)
+
+	req, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	if token := c.token.Token(); token != "" {
+		req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token))
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch %s: %w", path, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return nil, &statusCodeError{StatusCode: resp.StatusCode, Body: string(body)}
+	}
+
+	var parser expfmt.TextParser
+	families, err := parser.TextToMetricFamilies(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse prometheus exposition format from %s: %w", path, err)
+	}
+
+	filtered := make(map[string]*dto.MetricFamily, len(families))
+	for name, family := range families {
+		if len(name) >= len(volumeStatsFamilyPrefix) && name[:len(volumeStatsFamilyPrefix)] == volumeStatsFamilyPrefix {
+			filtered[name] = family
+		}
+	}
+	return filtered, nil
+}
+
+// volumeStatsFromMetrics is the collector that re-exports whatever
+// kubelet_volume_stats_* families were last scraped via --source=prometheus,
+// merged across every endpoint scraped (one per node in --mode=cluster). It
+// is registered once at startup and updated on every
+// collectFromPrometheusSource call.
+var volumeStatsFromMetrics = newPrometheusSourceCollector()
+
+func init() {
+	prometheus.MustRegister(volumeStatsFromMetrics)
+}
+
+// prometheusSourceCollector keys its stored families by the endpoint they
+// were scraped from, so concurrent per-node scrapes in --mode=cluster merge
+// into the exposed series instead of clobbering one another.
+type prometheusSourceCollector struct {
+	mu            sync.Mutex
+	familiesByKey map[string]map[string]*dto.MetricFamily
+}
+
+func newPrometheusSourceCollector() *prometheusSourceCollector {
+	return &prometheusSourceCollector{familiesByKey: make(map[string]map[string]*dto.MetricFamily)}
+}
+
+// update replaces the families stored for key (typically a scrape endpoint).
+func (p *prometheusSourceCollector) update(key string, families map[string]*dto.MetricFamily) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.familiesByKey[key] = families
+}
+
+// clear drops any families previously stored for key, e.g. after every
+// scrape path for that endpoint failed.
+func (p *prometheusSourceCollector) clear(key string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	delete(p.familiesByKey, key)
+}
+
+// Describe intentionally sends no descriptors: the set of families is only
+// known after the first scrape, which makes this an "unchecked collector" as
+// the prometheus registry supports for dynamic exporters.
+func (p *prometheusSourceCollector) Describe(ch chan<- *prometheus.Desc) {}
+
+func (p *prometheusSourceCollector) Collect(ch chan<- prometheus.Metric) {
+	p.mu.Lock()
+	familiesByKey := make([]map[string]*dto.MetricFamily, 0, len(p.familiesByKey))
+	for _, families := range p.familiesByKey {
+		familiesByKey = append(familiesByKey, families)
+	}
+	p.mu.Unlock()
+
+	for _, families := range familiesByKey {
+		for name, family := range families {
+			for _, m := range family.GetMetric() {
+				labelNames := make([]string, 0, len(m.GetLabel())+1)
+				labelValues := make([]string, 0, len(m.GetLabel())+1)
+				for _, lp := range m.GetLabel() {
+					labelNames = append(labelNames, lp.GetName())
+					labelValues = append(labelValues, lp.GetValue())
+				}
+				labelNames = append(labelNames, "source")
+				labelValues = append(labelValues, "metrics")
+
+				desc := prometheus.NewDesc(name, family.GetHelp(), labelNames, nil)
+
+				valueType, value := prometheusSourceValue(family, m)
+				metric, err := prometheus.NewConstMetric(desc, valueType, value, labelValues...)
+				if err != nil {
+					continue
+				}
+				ch <- metric
+			}
+		}
+	}
+}
+
+func prometheusSourceValue(family *dto.MetricFamily, m *dto.Metric) (prometheus.ValueType, float64) {
+	switch family.GetType() {
+	case dto.MetricType_COUNTER:
+		return prometheus.CounterValue, m.GetCounter().GetValue()
+	default:
+		return prometheus.GaugeValue, m.GetGauge().GetValue()
+	}
+}
@@ -0,0 +1,170 @@
+package main
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// tokenSource holds the current service account token and keeps it fresh by
+// re-reading it from disk on a timer. BoundServiceAccountTokenVolume
+// projected tokens (the default in modern clusters) are rotated on disk
+// roughly hourly; a token read once at startup would otherwise silently
+// expire and break every subsequent scrape.
+type tokenSource struct {
+	path   string
+	logger *zap.Logger
+
+	mu       sync.RWMutex
+	value    string
+	loadedAt time.Time
+}
+
+// newTokenSource reads path once to populate the initial token and returns
+// an error if that read fails; call run to keep it reloading afterwards.
+func newTokenSource(logger *zap.Logger, path string) (*tokenSource, error) {
+	t := &tokenSource{path: path, logger: logger}
+	if err := t.reload(); err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+// newEmptyTokenSource returns a tokenSource with no token loaded yet, for
+// callers that want to proceed unauthenticated after an initial read
+// failure but keep retrying on the same schedule.
+func newEmptyTokenSource(logger *zap.Logger, path string) *tokenSource {
+	return &tokenSource{path: path, logger: logger}
+}
+
+func (t *tokenSource) reload() error {
+	data, err := os.ReadFile(t.path)
+	if err != nil {
+		return fmt.Errorf("failed to read service account token: %w", err)
+	}
+
+	t.mu.Lock()
+	t.value = strings.TrimSpace(string(data))
+	t.loadedAt = time.Now()
+	t.mu.Unlock()
+
+	tokenReloadsTotal.Inc()
+	return nil
+}
+
+// Token returns the most recently loaded token, or the empty string if none
+// has been loaded yet.
+func (t *tokenSource) Token() string {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	return t.value
+}
+
+// LoadedAt returns when the token was last successfully reloaded.
+func (t *tokenSource) LoadedAt() time.Time {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	return t.loadedAt
+}
+
+// run reloads the token every interval until ctx is canceled, logging (but
+// not giving up on) reload failures so a transiently missing file doesn't
+// stop future attempts.
+func (t *tokenSource) run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := t.reload(); err != nil {
+				t.logger.Warn("Failed to reload service account token", zap.Error(err))
+			}
+		}
+	}
+}
+
+// caPool holds the kubelet CA bundle and keeps it fresh the same way
+// tokenSource does for the token, so a rotated CA doesn't require a restart
+// either. tls.Config.RootCAs can't be swapped after a client is built, so
+// tlsConfig instead disables the stdlib's built-in verification and
+// performs it manually against whatever pool was most recently loaded.
+type caPool struct {
+	path   string
+	logger *zap.Logger
+	pool   atomic.Value // *x509.CertPool
+}
+
+func newCAPool(logger *zap.Logger, path string) (*caPool, error) {
+	c := &caPool{path: path, logger: logger}
+	if err := c.reload(); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+func (c *caPool) reload() error {
+	data, err := os.ReadFile(c.path)
+	if err != nil {
+		return fmt.Errorf("failed to read kubelet CA bundle: %w", err)
+	}
+
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(data) {
+		return fmt.Errorf("failed to parse kubelet CA bundle %s", c.path)
+	}
+
+	c.pool.Store(pool)
+	return nil
+}
+
+// run reloads the CA bundle every interval until ctx is canceled.
+func (c *caPool) run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := c.reload(); err != nil {
+				c.logger.Warn("Failed to reload kubelet CA bundle", zap.Error(err))
+			}
+		}
+	}
+}
+
+// tlsConfig returns a *tls.Config that verifies the kubelet's certificate
+// against whichever CA pool was most recently loaded, re-evaluated on every
+// connection rather than fixed at client construction time.
+func (c *caPool) tlsConfig() *tls.Config {
+	return &tls.Config{
+		InsecureSkipVerify: true, // verification is done manually in VerifyConnection below
+		VerifyConnection: func(cs tls.ConnectionState) error {
+			pool, _ := c.pool.Load().(*x509.CertPool)
+
+			opts := x509.VerifyOptions{
+				DNSName:       cs.ServerName,
+				Roots:         pool,
+				Intermediates: x509.NewCertPool(),
+			}
+			for _, cert := range cs.PeerCertificates[1:] {
+				opts.Intermediates.AddCert(cert)
+			}
+
+			_, err := cs.PeerCertificates[0].Verify(opts)
+			return err
+		},
+	}
+}